@@ -1,11 +1,18 @@
 package av
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -81,18 +88,43 @@ func NewConnectionHost(host string) Connection {
 	}
 }
 
-// Request will make an HTTP GET request for the given endpoint from Alpha Vantage
+// Request will make an HTTP GET request for the given endpoint from Alpha Vantage.
+// Alpha Vantage returns errors and rate-limit notices as a JSON envelope with
+// an HTTP 200 status, even when a CSV payload was requested, so the body is
+// inspected here before being handed back to the caller.
 func (conn *avConnection) Request(endpoint *url.URL) (*http.Response, error) {
 	endpoint.Scheme = schemeHttps
 	endpoint.Host = conn.host
 	targetUrl := endpoint.String()
-	return conn.client.Get(targetUrl)
+
+	response, err := conn.client.Get(targetUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkResponseEnvelope(body); err != nil {
+		return nil, err
+	}
+
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return response, nil
 }
 
 // Client is a service used to query Alpha Vantage stock data
 type Client struct {
-	conn   Connection
-	apiKey string
+	conn        Connection
+	apiKey      string
+	retryPolicy RetryPolicy
+
+	subMu         sync.Mutex
+	subscriptions map[subscriptionKey][]context.CancelFunc
+	rateLimiter   *rate.Limiter
 }
 
 // NewClientConnection creates a new Client with the default Alpha Vantage connection
@@ -103,11 +135,42 @@ func NewClient(apiKey string) *Client {
 // NewClientConnection creates a Client with a specific connection
 func NewClientConnection(apiKey string, connection Connection) *Client {
 	return &Client{
-		conn:   connection,
-		apiKey: apiKey,
+		conn:        connection,
+		apiKey:      apiKey,
+		retryPolicy: DefaultRetryPolicy,
 	}
 }
 
+// SetRetryPolicy configures c to automatically retry requests that fail with
+// ErrRateLimited, using an exponential backoff. Pass a zero-value RetryPolicy
+// to disable retries.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+// withRetry runs fn, retrying according to c.retryPolicy whenever fn fails
+// with ErrRateLimited. Any other error stops the retries immediately.
+func (c *Client) withRetry(fn func() error) error {
+	if c.retryPolicy.MaxRetries == 0 {
+		return fn()
+	}
+
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = c.retryPolicy.InitialInterval
+	policy := backoff.WithMaxRetries(expBackoff, c.retryPolicy.MaxRetries)
+
+	return backoff.Retry(func() error {
+		err := fn()
+		if errors.Is(err, ErrRateLimited) {
+			return err
+		}
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		return nil
+	}, policy)
+}
+
 // buildRequestPath builds an endpoint URL with the given query parameters
 func (c *Client) buildRequestPath(params map[string]string) *url.URL {
 	// build our URL
@@ -138,12 +201,18 @@ func (c *Client) StockTimeSeriesIntraday(timeInterval TimeInterval, symbol strin
 		queryInterval: timeInterval.keyName(),
 		querySymbol:   symbol,
 	})
-	response, err := c.conn.Request(endpoint)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-	return parseTimeSeriesData(response.Body)
+
+	var series []*TimeSeriesValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		series, err = parseTimeSeriesData(response.Body)
+		return err
+	})
+	return series, err
 }
 
 // StockTimeSeries queries a stock symbols statistics for a given time frame.
@@ -151,16 +220,22 @@ func (c *Client) StockTimeSeriesIntraday(timeInterval TimeInterval, symbol strin
 // Data is returned from past to present.
 func (c *Client) StockTimeSeries(timeSeries TimeSeries, symbol string, optionalOutputSize ...OutputSize) ([]*TimeSeriesValue, error) {
 	endpoint := c.buildRequestPath(map[string]string{
-		queryEndpoint: timeSeries.keyName(),
-		querySymbol:   symbol,
+		queryEndpoint:   timeSeries.keyName(),
+		querySymbol:     symbol,
 		queryOutputSize: getOutputSize(optionalOutputSize),
 	})
-	response, err := c.conn.Request(endpoint)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-	return parseTimeSeriesData(response.Body)
+
+	var series []*TimeSeriesValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		series, err = parseTimeSeriesData(response.Body)
+		return err
+	})
+	return series, err
 }
 
 func getOutputSize(optionalOutputSize []OutputSize) string {
@@ -179,12 +254,18 @@ func (c *Client) DigitalCurrency(digital, physical string) ([]*DigitalCurrencySe
 		querySymbol:   digital,
 		queryMarket:   physical,
 	})
-	response, err := c.conn.Request(endpoint)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-	return parseDigitalCurrencySeriesData(response.Body)
+
+	var series []*DigitalCurrencySeriesValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		series, err = parseDigitalCurrencySeriesData(response.Body)
+		return err
+	})
+	return series, err
 }
 
 func (c *Client) SymbolSearch(keywords string) (*SymbolMatches, error) {
@@ -194,23 +275,23 @@ func (c *Client) SymbolSearch(keywords string) (*SymbolMatches, error) {
 		queryKeywords: keywords,
 	})
 
-	response, err := c.conn.Request(endpoint)
-
-	if err != nil {
-		return nil, err
-	}
-
-	defer response.Body.Close()
-	body, err := ioutil.ReadAll(response.Body)
-
-	if err != nil {
-		return nil, err
-	}
-
 	var matches *SymbolMatches
-	json.Unmarshal(body, &matches)
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+
+		defer response.Body.Close()
+		body, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return json.Unmarshal(body, &matches)
+	})
 
-	return matches, nil
+	return matches, err
 }
 	
 // StockQuote is a lightweight alternative to the time series APIs, this service returns the latest price and volume
@@ -220,10 +301,16 @@ func (c *Client) StockQuote(symbol string) (*QuoteValue, error) {
 		queryEndpoint: GlobalQuote,
 		querySymbol:   symbol,
 	})
-	response, err := c.conn.Request(endpoint)
-	if err != nil {
-		return nil, err
-	}
-	defer response.Body.Close()
-	return parseQuoteData(response.Body)
+
+	var quote *QuoteValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		quote, err = parseQuoteData(response.Body)
+		return err
+	})
+	return quote, err
 }
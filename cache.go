@@ -0,0 +1,156 @@
+package av
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Cache stores raw HTTP response bodies keyed by request, so repeated
+// StockQuote/StockTimeSeries calls during a session don't burn Alpha
+// Vantage's API quota.
+type Cache interface {
+	// Get returns the cached bytes for key, if present and not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores data under key for the given ttl.
+	Set(key string, data []byte, ttl time.Duration)
+}
+
+// DefaultCacheTTLs gives reasonable defaults per endpoint: intraday quotes
+// and bars stay fresh for a minute, and symbol search results for a day,
+// since they change far less often than price data. Daily/weekly/monthly
+// time series aren't listed here - they're cached until the next market
+// close instead of a fixed TTL, see marketCloseTTLEndpoints.
+var DefaultCacheTTLs = map[string]time.Duration{
+	GlobalQuote:                  time.Minute,
+	timeSeriesIntraday.keyName(): time.Minute,
+	valueSymbolSearchEndpoint:    24 * time.Hour,
+}
+
+// marketCloseTTLEndpoints are Alpha Vantage functions whose data only
+// changes once per trading day, so responses are cached until the next US
+// market close (4:00 PM ET) rather than a fixed duration.
+var marketCloseTTLEndpoints = map[string]bool{
+	Daily.keyName():   true,
+	Weekly.keyName():  true,
+	Monthly.keyName(): true,
+}
+
+// nyLocation is used to compute cache TTLs that should expire at the next
+// US market close, regardless of the caller's local timezone.
+var nyLocation = func() *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}()
+
+// marketCloseHour is 4:00 PM, when the US equity markets close.
+const marketCloseHour = 16
+
+// untilNextMarketClose returns how long until the next time US equity
+// markets close, skipping weekends. It does not account for market
+// holidays.
+func untilNextMarketClose(now time.Time) time.Duration {
+	nowET := now.In(nyLocation)
+	close := time.Date(nowET.Year(), nowET.Month(), nowET.Day(), marketCloseHour, 0, 0, 0, nyLocation)
+
+	if !nowET.Before(close) {
+		close = close.AddDate(0, 0, 1)
+	}
+	for close.Weekday() == time.Saturday || close.Weekday() == time.Sunday {
+		close = close.AddDate(0, 0, 1)
+	}
+
+	return close.Sub(now)
+}
+
+// cacheKey derives a stable cache key from endpoint, using the full query
+// string with the API key removed so identical requests from different
+// Client instances sharing a Cache hit the same entry.
+func cacheKey(endpoint *url.URL) string {
+	query := endpoint.Query()
+	query.Del(queryApiKey)
+	return endpoint.Path + "?" + query.Encode()
+}
+
+// CachingConnection decorates a Connection with a Cache, serving repeated
+// requests for the same endpoint from cache instead of hitting Alpha
+// Vantage again until the per-endpoint TTL expires.
+type CachingConnection struct {
+	conn       Connection
+	cache      Cache
+	ttls       map[string]time.Duration
+	defaultTTL time.Duration
+}
+
+// NewCachingConnection wraps conn with cache, using defaultTTL for any
+// endpoint not covered by a more specific entry in ttls.
+func NewCachingConnection(conn Connection, cache Cache, defaultTTL time.Duration, ttls map[string]time.Duration) *CachingConnection {
+	return &CachingConnection{
+		conn:       conn,
+		cache:      cache,
+		ttls:       ttls,
+		defaultTTL: defaultTTL,
+	}
+}
+
+// Request implements Connection, consulting the cache before making a
+// network round trip and populating it afterwards.
+func (c *CachingConnection) Request(endpoint *url.URL) (*http.Response, error) {
+	key := cacheKey(endpoint)
+	if data, ok := c.cache.Get(key); ok {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(data)),
+		}, nil
+	}
+
+	response, err := c.conn.Request(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Set(key, body, c.ttlFor(endpoint))
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return response, nil
+}
+
+// Unwrap returns the Connection c decorates, so code that needs to look
+// underneath a CachingConnection (e.g. Client.RemainingQuota finding a
+// rateLimitedConnection it wraps) can walk the decorator chain.
+func (c *CachingConnection) Unwrap() Connection {
+	return c.conn
+}
+
+// ttlFor returns the TTL to cache endpoint's response under: until the next
+// market close for daily/weekly/monthly time series, the configured TTL for
+// endpoint's function if one exists, or c.defaultTTL otherwise.
+func (c *CachingConnection) ttlFor(endpoint *url.URL) time.Duration {
+	function := endpoint.Query().Get(queryEndpoint)
+
+	if marketCloseTTLEndpoints[function] {
+		return untilNextMarketClose(time.Now())
+	}
+	if ttl, ok := c.ttls[function]; ok {
+		return ttl
+	}
+	return c.defaultTTL
+}
+
+// NewClientWithCache creates a Client whose requests are served from cache
+// when possible, using defaultTTL for any endpoint not covered by ttls.
+// Pass DefaultCacheTTLs for reasonable out-of-the-box behavior.
+func NewClientWithCache(apiKey string, cache Cache, defaultTTL time.Duration, ttls map[string]time.Duration) *Client {
+	conn := NewCachingConnection(NewConnection(), cache, defaultTTL, ttls)
+	return NewClientConnection(apiKey, conn)
+}
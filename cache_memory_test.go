@@ -0,0 +1,50 @@
+package av
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheGetSet(t *testing.T) {
+	cache := NewMemoryCache(2)
+
+	cache.Set("a", []byte("1"), time.Minute)
+
+	data, ok := cache.Get("a")
+	if !ok || string(data) != "1" {
+		t.Fatalf("expected cached value \"1\", got %q, %v", data, ok)
+	}
+
+	if _, ok := cache.Get("missing"); ok {
+		t.Fatalf("expected a miss for an unknown key")
+	}
+}
+
+func TestMemoryCacheExpiry(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", []byte("1"), -time.Second)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Fatalf("expected an already-expired entry to be evicted on read")
+	}
+}
+
+func TestMemoryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", []byte("1"), time.Minute)
+	cache.Set("b", []byte("2"), time.Minute)
+
+	// Touch "a" so "b" becomes the least recently used entry.
+	cache.Get("a")
+	cache.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Fatalf("expected the least recently used entry to be evicted")
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatalf("expected the recently used entry to survive eviction")
+	}
+	if _, ok := cache.Get("c"); !ok {
+		t.Fatalf("expected the newly inserted entry to be present")
+	}
+}
@@ -0,0 +1,57 @@
+package av
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrRateLimited is returned when Alpha Vantage's response body
+	// indicates the free tier's call frequency limit has been hit, even
+	// though the HTTP status code is 200.
+	ErrRateLimited = errors.New("av: rate limited by alpha vantage")
+
+	// ErrInvalidAPICall is returned when Alpha Vantage reports the request
+	// parameters were invalid via an "Error Message" envelope.
+	ErrInvalidAPICall = errors.New("av: invalid api call")
+
+	// ErrPremiumEndpoint is returned when the requested endpoint requires a
+	// premium Alpha Vantage subscription.
+	ErrPremiumEndpoint = errors.New("av: endpoint requires a premium subscription")
+)
+
+const premiumMarker = "premium"
+
+// errorEnvelope matches the JSON body Alpha Vantage returns in place of the
+// requested payload - with an HTTP 200 status - when a request is rate
+// limited, malformed, or targets a premium-only endpoint.
+type errorEnvelope struct {
+	Note         string `json:"Note"`
+	Information  string `json:"Information"`
+	ErrorMessage string `json:"Error Message"`
+}
+
+// checkResponseEnvelope inspects body for one of Alpha Vantage's JSON error
+// envelopes. It returns nil when body looks like an ordinary payload.
+func checkResponseEnvelope(body []byte) error {
+	var envelope errorEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		// Not a JSON object, so this is an ordinary CSV or JSON payload.
+		return nil
+	}
+
+	switch {
+	case envelope.ErrorMessage != "":
+		return fmt.Errorf("%w: %s", ErrInvalidAPICall, envelope.ErrorMessage)
+	case envelope.Note != "":
+		return fmt.Errorf("%w: %s", ErrRateLimited, envelope.Note)
+	case envelope.Information != "":
+		if strings.Contains(strings.ToLower(envelope.Information), premiumMarker) {
+			return fmt.Errorf("%w: %s", ErrPremiumEndpoint, envelope.Information)
+		}
+		return fmt.Errorf("%w: %s", ErrRateLimited, envelope.Information)
+	}
+	return nil
+}
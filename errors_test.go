@@ -0,0 +1,59 @@
+package av
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckResponseEnvelope(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{
+			name:    "ordinary json payload",
+			body:    `{"Global Quote": {"05. price": "1.23"}}`,
+			wantErr: nil,
+		},
+		{
+			name: "ordinary csv payload",
+			body: "date,open,high\n2020-01-01,1,2",
+		},
+		{
+			name:    "rate limit note",
+			body:    `{"Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute."}`,
+			wantErr: ErrRateLimited,
+		},
+		{
+			name:    "rate limit information",
+			body:    `{"Information": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute."}`,
+			wantErr: ErrRateLimited,
+		},
+		{
+			name:    "premium endpoint",
+			body:    `{"Information": "This is a premium endpoint."}`,
+			wantErr: ErrPremiumEndpoint,
+		},
+		{
+			name:    "invalid api call",
+			body:    `{"Error Message": "Invalid API call. Please retry or visit the documentation."}`,
+			wantErr: ErrInvalidAPICall,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkResponseEnvelope([]byte(tt.body))
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error wrapping %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
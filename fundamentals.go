@@ -0,0 +1,230 @@
+package av
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	valueOverviewEndpoint        = "OVERVIEW"
+	valueIncomeStatementEndpoint = "INCOME_STATEMENT"
+	valueBalanceSheetEndpoint    = "BALANCE_SHEET"
+	valueCashFlowEndpoint        = "CASH_FLOW"
+	valueEarningsEndpoint        = "EARNINGS"
+)
+
+// parseJSON reads body fully and unmarshals it into target. Most
+// fundamental-data endpoints only return JSON, unlike the time series
+// endpoints which default to CSV.
+func parseJSON(body io.Reader, target interface{}) error {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// CompanyOverviewValue holds the company description and key metrics
+// returned by the OVERVIEW endpoint.
+type CompanyOverviewValue struct {
+	Symbol               string `json:"Symbol"`
+	Name                 string `json:"Name"`
+	Description          string `json:"Description"`
+	Exchange             string `json:"Exchange"`
+	Currency             string `json:"Currency"`
+	Sector               string `json:"Sector"`
+	Industry             string `json:"Industry"`
+	MarketCapitalization string `json:"MarketCapitalization"`
+	PERatio              string `json:"PERatio"`
+	DividendYield        string `json:"DividendYield"`
+	EPS                  string `json:"EPS"`
+}
+
+// CompanyOverview queries high level company information and key financial
+// metrics for symbol.
+func (c *Client) CompanyOverview(symbol string) (*CompanyOverviewValue, error) {
+	endpoint := c.buildRequestPath(map[string]string{
+		queryEndpoint: valueOverviewEndpoint,
+		queryDataType: valueJson,
+		querySymbol:   symbol,
+	})
+
+	var overview *CompanyOverviewValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		return parseJSON(response.Body, &overview)
+	})
+	return overview, err
+}
+
+// FinancialReport is one fiscal period's line items, shared by the annual
+// and quarterly reports in IncomeStatementValue, BalanceSheetValue, and
+// CashFlowValue.
+type FinancialReport struct {
+	FiscalDateEnding string `json:"fiscalDateEnding"`
+	ReportedCurrency string `json:"reportedCurrency"`
+}
+
+// IncomeStatementReport is one period's income statement line items.
+type IncomeStatementReport struct {
+	FinancialReport
+	TotalRevenue    string `json:"totalRevenue"`
+	GrossProfit     string `json:"grossProfit"`
+	OperatingIncome string `json:"operatingIncome"`
+	NetIncome       string `json:"netIncome"`
+	EBITDA          string `json:"ebitda"`
+}
+
+// IncomeStatementValue holds the annual and quarterly income statements
+// returned by the INCOME_STATEMENT endpoint.
+type IncomeStatementValue struct {
+	Symbol           string                   `json:"symbol"`
+	AnnualReports    []*IncomeStatementReport `json:"annualReports"`
+	QuarterlyReports []*IncomeStatementReport `json:"quarterlyReports"`
+}
+
+// IncomeStatement queries the annual and quarterly income statements for
+// symbol.
+func (c *Client) IncomeStatement(symbol string) (*IncomeStatementValue, error) {
+	endpoint := c.buildRequestPath(map[string]string{
+		queryEndpoint: valueIncomeStatementEndpoint,
+		queryDataType: valueJson,
+		querySymbol:   symbol,
+	})
+
+	var statement *IncomeStatementValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		return parseJSON(response.Body, &statement)
+	})
+	return statement, err
+}
+
+// BalanceSheetReport is one period's balance sheet line items.
+type BalanceSheetReport struct {
+	FinancialReport
+	TotalAssets                           string `json:"totalAssets"`
+	TotalLiabilities                      string `json:"totalLiabilities"`
+	TotalShareholderEquity                string `json:"totalShareholderEquity"`
+	CashAndCashEquivalentsAtCarryingValue string `json:"cashAndCashEquivalentsAtCarryingValue"`
+}
+
+// BalanceSheetValue holds the annual and quarterly balance sheets returned
+// by the BALANCE_SHEET endpoint.
+type BalanceSheetValue struct {
+	Symbol           string                `json:"symbol"`
+	AnnualReports    []*BalanceSheetReport `json:"annualReports"`
+	QuarterlyReports []*BalanceSheetReport `json:"quarterlyReports"`
+}
+
+// BalanceSheet queries the annual and quarterly balance sheets for symbol.
+func (c *Client) BalanceSheet(symbol string) (*BalanceSheetValue, error) {
+	endpoint := c.buildRequestPath(map[string]string{
+		queryEndpoint: valueBalanceSheetEndpoint,
+		queryDataType: valueJson,
+		querySymbol:   symbol,
+	})
+
+	var sheet *BalanceSheetValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		return parseJSON(response.Body, &sheet)
+	})
+	return sheet, err
+}
+
+// CashFlowReport is one period's cash flow statement line items.
+type CashFlowReport struct {
+	FinancialReport
+	OperatingCashflow      string `json:"operatingCashflow"`
+	CapitalExpenditures    string `json:"capitalExpenditures"`
+	CashflowFromInvestment string `json:"cashflowFromInvestment"`
+	CashflowFromFinancing  string `json:"cashflowFromFinancing"`
+	NetIncome              string `json:"netIncome"`
+}
+
+// CashFlowValue holds the annual and quarterly cash flow statements
+// returned by the CASH_FLOW endpoint.
+type CashFlowValue struct {
+	Symbol           string            `json:"symbol"`
+	AnnualReports    []*CashFlowReport `json:"annualReports"`
+	QuarterlyReports []*CashFlowReport `json:"quarterlyReports"`
+}
+
+// CashFlow queries the annual and quarterly cash flow statements for
+// symbol.
+func (c *Client) CashFlow(symbol string) (*CashFlowValue, error) {
+	endpoint := c.buildRequestPath(map[string]string{
+		queryEndpoint: valueCashFlowEndpoint,
+		queryDataType: valueJson,
+		querySymbol:   symbol,
+	})
+
+	var flow *CashFlowValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		return parseJSON(response.Body, &flow)
+	})
+	return flow, err
+}
+
+// AnnualEarning is one fiscal year's reported earnings per share.
+type AnnualEarning struct {
+	FiscalDateEnding string `json:"fiscalDateEnding"`
+	ReportedEPS      string `json:"reportedEPS"`
+}
+
+// QuarterlyEarning is one fiscal quarter's reported and estimated earnings.
+type QuarterlyEarning struct {
+	FiscalDateEnding   string `json:"fiscalDateEnding"`
+	ReportedDate       string `json:"reportedDate"`
+	ReportedEPS        string `json:"reportedEPS"`
+	EstimatedEPS       string `json:"estimatedEPS"`
+	Surprise           string `json:"surprise"`
+	SurprisePercentage string `json:"surprisePercentage"`
+}
+
+// EarningsValue holds the annual and quarterly earnings returned by the
+// EARNINGS endpoint.
+type EarningsValue struct {
+	Symbol            string              `json:"symbol"`
+	AnnualEarnings    []*AnnualEarning    `json:"annualEarnings"`
+	QuarterlyEarnings []*QuarterlyEarning `json:"quarterlyEarnings"`
+}
+
+// Earnings queries the annual and quarterly earnings history for symbol.
+func (c *Client) Earnings(symbol string) (*EarningsValue, error) {
+	endpoint := c.buildRequestPath(map[string]string{
+		queryEndpoint: valueEarningsEndpoint,
+		queryDataType: valueJson,
+		querySymbol:   symbol,
+	})
+
+	var earnings *EarningsValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		return parseJSON(response.Body, &earnings)
+	})
+	return earnings, err
+}
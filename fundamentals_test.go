@@ -0,0 +1,69 @@
+package av
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseJSONCompanyOverview(t *testing.T) {
+	body := `{
+		"Symbol": "IBM",
+		"Name": "International Business Machines",
+		"Description": "IBM is a technology company.",
+		"Exchange": "NYSE",
+		"Currency": "USD",
+		"Sector": "TECHNOLOGY",
+		"Industry": "COMPUTER & OFFICE EQUIPMENT",
+		"MarketCapitalization": "123456789",
+		"PERatio": "22.5",
+		"DividendYield": "0.045",
+		"EPS": "8.23"
+	}`
+
+	var overview *CompanyOverviewValue
+	if err := parseJSON(strings.NewReader(body), &overview); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if overview.Symbol != "IBM" || overview.Name != "International Business Machines" {
+		t.Fatalf("unexpected overview: %+v", overview)
+	}
+	if overview.PERatio != "22.5" || overview.EPS != "8.23" {
+		t.Fatalf("unexpected overview metrics: %+v", overview)
+	}
+}
+
+func TestParseJSONIncomeStatement(t *testing.T) {
+	body := `{
+		"symbol": "IBM",
+		"annualReports": [
+			{
+				"fiscalDateEnding": "2023-12-31",
+				"reportedCurrency": "USD",
+				"totalRevenue": "61860000000",
+				"grossProfit": "34300000000",
+				"operatingIncome": "8600000000",
+				"netIncome": "7500000000",
+				"ebitda": "12900000000"
+			}
+		],
+		"quarterlyReports": []
+	}`
+
+	var statement *IncomeStatementValue
+	if err := parseJSON(strings.NewReader(body), &statement); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if statement.Symbol != "IBM" {
+		t.Fatalf("unexpected symbol: %q", statement.Symbol)
+	}
+	if len(statement.AnnualReports) != 1 {
+		t.Fatalf("expected 1 annual report, got %d", len(statement.AnnualReports))
+	}
+
+	report := statement.AnnualReports[0]
+	if report.FiscalDateEnding != "2023-12-31" || report.NetIncome != "7500000000" {
+		t.Fatalf("unexpected annual report: %+v", report)
+	}
+}
@@ -0,0 +1,71 @@
+package av
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+const valueListingStatusEndpoint = "LISTING_STATUS"
+
+// ListingStatusEntry is one row of the LISTING_STATUS endpoint's output,
+// describing a single listed or delisted security.
+type ListingStatusEntry struct {
+	Symbol        string
+	Name          string
+	Exchange      string
+	AssetType     string
+	IPODate       string
+	DelistingDate string
+	Status        string
+}
+
+// ListingStatus queries Alpha Vantage's current list of active or delisted
+// US stocks and ETFs. Unlike the other fundamentals endpoints, this one
+// only returns CSV.
+func (c *Client) ListingStatus() ([]*ListingStatusEntry, error) {
+	endpoint := c.buildRequestPath(map[string]string{
+		queryEndpoint: valueListingStatusEndpoint,
+	})
+
+	var entries []*ListingStatusEntry
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		entries, err = parseListingStatusData(response.Body)
+		return err
+	})
+	return entries, err
+}
+
+// parseListingStatusData parses the LISTING_STATUS endpoint's CSV body,
+// whose header row is symbol,name,exchange,assetType,ipoDate,
+// delistingDate,status.
+func parseListingStatusData(body io.Reader) ([]*ListingStatusEntry, error) {
+	rows, err := csv.NewReader(body).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	entries := make([]*ListingStatusEntry, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		if len(row) < 7 {
+			continue
+		}
+		entries = append(entries, &ListingStatusEntry{
+			Symbol:        row[0],
+			Name:          row[1],
+			Exchange:      row[2],
+			AssetType:     row[3],
+			IPODate:       row[4],
+			DelistingDate: row[5],
+			Status:        row[6],
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,34 @@
+package av
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseListingStatusData(t *testing.T) {
+	body := strings.NewReader(
+		"symbol,name,exchange,assetType,ipoDate,delistingDate,status\n" +
+			"AAPL,Apple Inc,NASDAQ,Stock,1980-12-12,,Active\n",
+	)
+
+	entries, err := parseListingStatusData(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+
+	got := entries[0]
+	want := &ListingStatusEntry{
+		Symbol:    "AAPL",
+		Name:      "Apple Inc",
+		Exchange:  "NASDAQ",
+		AssetType: "Stock",
+		IPODate:   "1980-12-12",
+		Status:    "Active",
+	}
+	if *got != *want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
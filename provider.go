@@ -0,0 +1,107 @@
+package av
+
+import "errors"
+
+// ErrNoTimeSeriesProvider is returned by MultiProviderClient.StockTimeSeries
+// when none of its registered providers implement TimeSeriesProvider.
+var ErrNoTimeSeriesProvider = errors.New("av: no registered provider supports time series")
+
+// QuoteProvider is implemented by any quote source that can be registered
+// with a MultiProviderClient as a fallback for Alpha Vantage.
+type QuoteProvider interface {
+	// Quote returns the latest price and volume information for the given symbol.
+	Quote(symbol string) (*QuoteValue, error)
+}
+
+// TimeSeriesProvider is implemented by quote sources that can also supply
+// historical time series data, for use as a fallback for
+// MultiProviderClient.StockTimeSeries. Not every QuoteProvider needs to
+// implement it.
+type TimeSeriesProvider interface {
+	// TimeSeries returns symbol's historical statistics for the given
+	// TimeSeries, from past to present.
+	TimeSeries(timeSeries TimeSeries, symbol string) ([]*TimeSeriesValue, error)
+}
+
+// alphaVantageProvider adapts Client to QuoteProvider and TimeSeriesProvider
+// so the primary Alpha Vantage source can be chained with fallback
+// providers.
+type alphaVantageProvider struct {
+	client *Client
+}
+
+func (p *alphaVantageProvider) Quote(symbol string) (*QuoteValue, error) {
+	return p.client.StockQuote(symbol)
+}
+
+func (p *alphaVantageProvider) TimeSeries(timeSeries TimeSeries, symbol string) ([]*TimeSeriesValue, error) {
+	return p.client.StockTimeSeries(timeSeries, symbol)
+}
+
+// MultiProviderClient queries a list of QuoteProvider implementations in
+// priority order, falling back to the next provider when one returns an
+// error or no result. This gives callers resilience against Alpha Vantage's
+// rate limits without changing their StockQuote call sites.
+type MultiProviderClient struct {
+	providers []QuoteProvider
+}
+
+// NewMultiProviderClient creates a MultiProviderClient that queries the given
+// Client first, then falls back to the supplied providers in order. client's
+// own retry policy is not used for the primary provider: retrying Alpha
+// Vantage's exponential backoff before falling back would block for as long
+// as client's configured retries take, defeating the point of having
+// fallbacks. Instead a copy of client with retries disabled queries Alpha
+// Vantage exactly once before MultiProviderClient moves on to fallbacks.
+func NewMultiProviderClient(client *Client, fallbacks ...QuoteProvider) *MultiProviderClient {
+	primary := NewClientConnection(client.apiKey, client.conn)
+	primary.SetRetryPolicy(RetryPolicy{})
+
+	providers := make([]QuoteProvider, 0, len(fallbacks)+1)
+	providers = append(providers, &alphaVantageProvider{client: primary})
+	providers = append(providers, fallbacks...)
+	return &MultiProviderClient{providers: providers}
+}
+
+// StockQuote returns the latest quote for symbol, trying each registered
+// provider in order until one succeeds.
+func (m *MultiProviderClient) StockQuote(symbol string) (*QuoteValue, error) {
+	var lastErr error
+	for _, provider := range m.providers {
+		quote, err := provider.Quote(symbol)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if quote == nil {
+			continue
+		}
+		return quote, nil
+	}
+	return nil, lastErr
+}
+
+// StockTimeSeries returns symbol's historical statistics for the given
+// TimeSeries, trying each registered provider that implements
+// TimeSeriesProvider in order until one succeeds. It returns
+// ErrNoTimeSeriesProvider if none of m's providers support time series.
+func (m *MultiProviderClient) StockTimeSeries(timeSeries TimeSeries, symbol string) ([]*TimeSeriesValue, error) {
+	lastErr := ErrNoTimeSeriesProvider
+	for _, provider := range m.providers {
+		tsProvider, ok := provider.(TimeSeriesProvider)
+		if !ok {
+			continue
+		}
+
+		series, err := tsProvider.TimeSeries(timeSeries, symbol)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(series) == 0 {
+			continue
+		}
+		return series, nil
+	}
+	return nil, lastErr
+}
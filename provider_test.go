@@ -0,0 +1,95 @@
+package av
+
+import "testing"
+
+type stubProvider struct {
+	quote    *QuoteValue
+	quoteErr error
+
+	series    []*TimeSeriesValue
+	seriesErr error
+}
+
+func (s *stubProvider) Quote(symbol string) (*QuoteValue, error) {
+	return s.quote, s.quoteErr
+}
+
+func (s *stubProvider) TimeSeries(timeSeries TimeSeries, symbol string) ([]*TimeSeriesValue, error) {
+	return s.series, s.seriesErr
+}
+
+func TestMultiProviderClientStockQuoteFallsBackOnError(t *testing.T) {
+	want := &QuoteValue{Symbol: "AAPL"}
+	m := &MultiProviderClient{providers: []QuoteProvider{
+		&stubProvider{quoteErr: ErrRateLimited},
+		&stubProvider{quote: want},
+	}}
+
+	got, err := m.StockQuote("AAPL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != want {
+		t.Fatalf("expected fallback provider's quote, got %v", got)
+	}
+}
+
+func TestMultiProviderClientStockQuoteFallsBackOnNilResult(t *testing.T) {
+	want := &QuoteValue{Symbol: "AAPL"}
+	m := &MultiProviderClient{providers: []QuoteProvider{
+		&stubProvider{quote: nil},
+		&stubProvider{quote: want},
+	}}
+
+	got, err := m.StockQuote("AAPL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != want {
+		t.Fatalf("expected fallback provider's quote, got %v", got)
+	}
+}
+
+func TestMultiProviderClientStockQuoteReturnsLastError(t *testing.T) {
+	m := &MultiProviderClient{providers: []QuoteProvider{
+		&stubProvider{quoteErr: ErrRateLimited},
+	}}
+
+	if _, err := m.StockQuote("AAPL"); err != ErrRateLimited {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+}
+
+func TestMultiProviderClientStockTimeSeriesSkipsNonTimeSeriesProviders(t *testing.T) {
+	want := []*TimeSeriesValue{{}}
+	m := &MultiProviderClient{providers: []QuoteProvider{
+		&quoteOnlyProvider{},
+		&stubProvider{series: want},
+	}}
+
+	got, err := m.StockTimeSeries(Daily, "AAPL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected the TimeSeriesProvider's series, got %v", got)
+	}
+}
+
+func TestMultiProviderClientStockTimeSeriesReturnsErrNoTimeSeriesProvider(t *testing.T) {
+	m := &MultiProviderClient{providers: []QuoteProvider{
+		&quoteOnlyProvider{},
+	}}
+
+	if _, err := m.StockTimeSeries(Daily, "AAPL"); err != ErrNoTimeSeriesProvider {
+		t.Fatalf("expected ErrNoTimeSeriesProvider, got %v", err)
+	}
+}
+
+// quoteOnlyProvider implements QuoteProvider but not TimeSeriesProvider, to
+// verify StockTimeSeries skips providers that don't support it.
+type quoteOnlyProvider struct{}
+
+func (q *quoteOnlyProvider) Quote(symbol string) (*QuoteValue, error) {
+	return nil, nil
+}
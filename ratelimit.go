@@ -0,0 +1,197 @@
+package av
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Alpha Vantage's free-tier request quotas.
+const (
+	DefaultPerMinuteLimit = 5
+	DefaultPerDayLimit    = 500
+)
+
+// ErrDailyQuotaExceeded is returned immediately, without a network round
+// trip, once a rate-limited Connection's daily request quota is exhausted.
+var ErrDailyQuotaExceeded = errors.New("av: daily request quota exceeded")
+
+// rateLimitedConnection wraps a Connection with a limiter enforcing a
+// per-minute sliding window and a per-day counter, so concurrent
+// goroutines sharing a Client serialize through it instead of triggering
+// ErrRateLimited responses.
+type rateLimitedConnection struct {
+	conn Connection
+
+	mu        sync.Mutex
+	perMinute int
+	perDay    int
+
+	// minuteHistory holds the timestamp of each request made in the last
+	// minute, oldest first, so the per-minute limit is enforced as a true
+	// sliding window instead of a fixed window that could otherwise let a
+	// caller burst up to 2x perMinute requests across a window boundary.
+	minuteHistory []time.Time
+
+	dayWindow time.Time
+	dayCount  int
+}
+
+// NewConnectionWithLimits creates a Connection at host that enforces
+// perMinute and perDay request quotas across all goroutines using it.
+// Use DefaultPerMinuteLimit and DefaultPerDayLimit for Alpha Vantage's
+// free-tier quotas.
+func NewConnectionWithLimits(host string, perMinute, perDay int) Connection {
+	return &rateLimitedConnection{
+		conn:      NewConnectionHost(host),
+		perMinute: perMinute,
+		perDay:    perDay,
+	}
+}
+
+// Request implements Connection, blocking until a slot is free within the
+// per-minute window, or failing immediately with ErrDailyQuotaExceeded once
+// the daily quota is spent.
+func (r *rateLimitedConnection) Request(endpoint *url.URL) (*http.Response, error) {
+	if err := r.reserve(); err != nil {
+		return nil, err
+	}
+	return r.conn.Request(endpoint)
+}
+
+// reserve blocks until a per-minute slot is available, or returns
+// ErrDailyQuotaExceeded if the daily quota is already spent.
+func (r *rateLimitedConnection) reserve() error {
+	for {
+		wait, err := r.tryReserve()
+		if err != nil {
+			return err
+		}
+		if wait <= 0 {
+			return nil
+		}
+		time.Sleep(wait)
+	}
+}
+
+// dropExpired removes timestamps older than one minute from history, which
+// is kept sorted oldest-first.
+func dropExpired(history []time.Time, now time.Time) []time.Time {
+	cutoff := now.Add(-time.Minute)
+	i := 0
+	for i < len(history) && !history[i].After(cutoff) {
+		i++
+	}
+	return history[i:]
+}
+
+// tryReserve attempts to consume one request from both the per-minute
+// sliding window and the per-day counter, rolling the latter forward as it
+// expires. It returns how long to wait before trying again when the
+// per-minute window is full.
+func (r *rateLimitedConnection) tryReserve() (time.Duration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	if now.After(r.dayWindow) {
+		r.dayWindow = now.Add(24 * time.Hour)
+		r.dayCount = 0
+	}
+	if r.dayCount >= r.perDay {
+		return 0, ErrDailyQuotaExceeded
+	}
+
+	r.minuteHistory = dropExpired(r.minuteHistory, now)
+	if len(r.minuteHistory) >= r.perMinute {
+		return r.minuteHistory[0].Add(time.Minute).Sub(now), nil
+	}
+
+	r.minuteHistory = append(r.minuteHistory, now)
+	r.dayCount++
+	return 0, nil
+}
+
+// remainingQuota reports requests left in the current minute's sliding
+// window and the current day's counter.
+func (r *rateLimitedConnection) remainingQuota() (perMinute, perDay int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+
+	r.minuteHistory = dropExpired(r.minuteHistory, now)
+	minuteRemaining := r.perMinute - len(r.minuteHistory)
+
+	dayRemaining := r.perDay
+	if !now.After(r.dayWindow) {
+		dayRemaining = r.perDay - r.dayCount
+	}
+
+	return minuteRemaining, dayRemaining
+}
+
+// nextAvailable reports when the next request slot opens, or the zero time
+// if one is available now.
+func (r *rateLimitedConnection) nextAvailable() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if !now.After(r.dayWindow) && r.dayCount >= r.perDay {
+		return r.dayWindow
+	}
+
+	r.minuteHistory = dropExpired(r.minuteHistory, now)
+	if len(r.minuteHistory) < r.perMinute {
+		return time.Time{}
+	}
+	return r.minuteHistory[0].Add(time.Minute)
+}
+
+// unwrappableConnection is implemented by Connection decorators (e.g.
+// CachingConnection) that wrap another Connection, so findRateLimitedConnection
+// can see through them.
+type unwrappableConnection interface {
+	Unwrap() Connection
+}
+
+// findRateLimitedConnection walks conn's decorator chain looking for a
+// *rateLimitedConnection, since it may be wrapped by other Connection
+// decorators such as CachingConnection.
+func findRateLimitedConnection(conn Connection) (*rateLimitedConnection, bool) {
+	for {
+		if limited, ok := conn.(*rateLimitedConnection); ok {
+			return limited, true
+		}
+		unwrappable, ok := conn.(unwrappableConnection)
+		if !ok {
+			return nil, false
+		}
+		conn = unwrappable.Unwrap()
+	}
+}
+
+// RemainingQuota returns the number of requests left in the current minute
+// and day windows, if c's Connection was created with
+// NewConnectionWithLimits, including when wrapped by other decorators such
+// as CachingConnection. It returns (0, 0) otherwise.
+func (c *Client) RemainingQuota() (perMinute, perDay int) {
+	if limited, ok := findRateLimitedConnection(c.conn); ok {
+		return limited.remainingQuota()
+	}
+	return 0, 0
+}
+
+// NextAvailable returns when c's next request can proceed without waiting,
+// or the zero time if one can proceed now or c's Connection does not
+// enforce rate limits.
+func (c *Client) NextAvailable() time.Time {
+	if limited, ok := findRateLimitedConnection(c.conn); ok {
+		return limited.nextAvailable()
+	}
+	return time.Time{}
+}
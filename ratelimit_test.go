@@ -0,0 +1,87 @@
+package av
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimitedConnectionEnforcesPerMinuteLimit(t *testing.T) {
+	conn := &rateLimitedConnection{perMinute: 2, perDay: 100}
+
+	for i := 0; i < 2; i++ {
+		if _, err := conn.tryReserve(); err != nil {
+			t.Fatalf("unexpected error on reservation %d: %v", i, err)
+		}
+	}
+
+	wait, err := conn.tryReserve()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wait <= 0 {
+		t.Fatalf("expected a positive wait once the per-minute limit is hit, got %v", wait)
+	}
+}
+
+func TestRateLimitedConnectionEnforcesDailyQuota(t *testing.T) {
+	conn := &rateLimitedConnection{perMinute: 100, perDay: 1}
+
+	if _, err := conn.tryReserve(); err != nil {
+		t.Fatalf("unexpected error on first reservation: %v", err)
+	}
+
+	if _, err := conn.tryReserve(); !errors.Is(err, ErrDailyQuotaExceeded) {
+		t.Fatalf("expected ErrDailyQuotaExceeded once the daily quota is spent, got %v", err)
+	}
+}
+
+func TestRateLimitedConnectionSlidingWindowPreventsBoundaryBurst(t *testing.T) {
+	conn := &rateLimitedConnection{perMinute: 2, perDay: 100}
+
+	now := time.Now()
+	// Simulate 2 requests made just under a minute ago, as a fixed window
+	// that had just reset would allow a 3rd and 4th request here instead of
+	// waiting for those 2 to age out of the sliding window.
+	conn.minuteHistory = []time.Time{now.Add(-59 * time.Second), now.Add(-58 * time.Second)}
+
+	wait, err := conn.tryReserve()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wait <= 0 {
+		t.Fatalf("expected to wait for the oldest request to age out of the sliding window, got %v", wait)
+	}
+}
+
+func TestRateLimitedConnectionRemainingQuota(t *testing.T) {
+	conn := &rateLimitedConnection{perMinute: 5, perDay: 10}
+
+	if _, err := conn.tryReserve(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	perMinute, perDay := conn.remainingQuota()
+	if perMinute != 4 || perDay != 9 {
+		t.Fatalf("expected (4, 9) remaining, got (%d, %d)", perMinute, perDay)
+	}
+}
+
+func TestClientRemainingQuotaUnwrapsCachingConnection(t *testing.T) {
+	limited := &rateLimitedConnection{perMinute: 5, perDay: 10}
+	if _, err := limited.tryReserve(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	caching := NewCachingConnection(limited, NewMemoryCache(10), time.Minute, nil)
+	client := NewClientConnection("key", caching)
+
+	perMinute, perDay := client.RemainingQuota()
+	if perMinute != 4 || perDay != 9 {
+		t.Fatalf("expected (4, 9) remaining through a CachingConnection, got (%d, %d)", perMinute, perDay)
+	}
+
+	if next := client.NextAvailable(); !next.IsZero() {
+		t.Fatalf("expected a zero time when a slot is available now, got %v", next)
+	}
+}
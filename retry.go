@@ -0,0 +1,21 @@
+package av
+
+import "time"
+
+// RetryPolicy configures automatic retries when Alpha Vantage responds with
+// ErrRateLimited, which is common on the free tier's 5 request/minute limit.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of additional attempts after the
+	// initial request. Zero disables retries.
+	MaxRetries uint64
+	// InitialInterval is the delay before the first retry; later retries
+	// back off from this value exponentially.
+	InitialInterval time.Duration
+}
+
+// DefaultRetryPolicy retries up to 3 times with an exponential backoff
+// starting at 15 seconds, enough to clear a single rate-limit window.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:      3,
+	InitialInterval: 15 * time.Second,
+}
@@ -0,0 +1,71 @@
+// Package sqlitecache implements an av.Cache backed by a SQLite database, so
+// cached responses survive process restarts. It is kept out of the av
+// package because it pulls in a cgo dependency (mattn/go-sqlite3); importing
+// it is therefore opt-in, like the yahoo sub-package.
+package sqlitecache
+
+import (
+	"database/sql"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const createTableSQL = `
+CREATE TABLE IF NOT EXISTS response_cache (
+	key        TEXT PRIMARY KEY,
+	data       BLOB NOT NULL,
+	expires_at INTEGER NOT NULL
+)`
+
+// Cache is an av.Cache backed by a SQLite database.
+type Cache struct {
+	db *sql.DB
+}
+
+// New opens (creating if necessary) a SQLite database at path and returns a
+// Cache backed by it.
+func New(path string) (*Cache, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(createTableSQL); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Cache{db: db}, nil
+}
+
+// Get implements av.Cache.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	var data []byte
+	var expiresAt int64
+
+	row := c.db.QueryRow(`SELECT data, expires_at FROM response_cache WHERE key = ?`, key)
+	if err := row.Scan(&data, &expiresAt); err != nil {
+		return nil, false
+	}
+
+	if time.Now().Unix() > expiresAt {
+		c.db.Exec(`DELETE FROM response_cache WHERE key = ?`, key)
+		return nil, false
+	}
+
+	return data, true
+}
+
+// Set implements av.Cache.
+func (c *Cache) Set(key string, data []byte, ttl time.Duration) {
+	expiresAt := time.Now().Add(ttl).Unix()
+	c.db.Exec(`INSERT INTO response_cache (key, data, expires_at) VALUES (?, ?, ?)
+		ON CONFLICT(key) DO UPDATE SET data = excluded.data, expires_at = excluded.expires_at`,
+		key, data, expiresAt)
+}
+
+// Close releases the underlying database handle.
+func (c *Cache) Close() error {
+	return c.db.Close()
+}
@@ -0,0 +1,239 @@
+package av
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// subscriptionRatePerMinute is the free-tier call frequency Alpha Vantage
+// allows, shared across every symbol a Client has subscribed to.
+const subscriptionRatePerMinute = 5
+
+// subscriptionKind distinguishes SubscribeQuotes from SubscribeBars
+// subscriptions, so subscribing to both for the same symbol doesn't
+// overwrite either one's cancel func.
+type subscriptionKind int
+
+const (
+	quoteSubscription subscriptionKind = iota
+	barSubscription
+)
+
+// subscriptionKey identifies one poller goroutine tracked in
+// Client.subscriptions.
+type subscriptionKey struct {
+	kind   subscriptionKind
+	symbol string
+}
+
+// QuoteEvent is emitted on the channel returned by SubscribeQuotes whenever
+// a new quote is observed for a subscribed symbol.
+type QuoteEvent struct {
+	Symbol     string
+	Value      *QuoteValue
+	Err        error
+	ReceivedAt time.Time
+}
+
+// BarEvent is emitted on the channel returned by SubscribeBars whenever a
+// new intraday bar is observed for a subscribed symbol.
+type BarEvent struct {
+	Symbol     string
+	Value      *TimeSeriesValue
+	Err        error
+	ReceivedAt time.Time
+}
+
+// limiter lazily creates the Client's shared rate limiter, so every
+// subscription on c stays within Alpha Vantage's free-tier quota.
+func (c *Client) limiter() *rate.Limiter {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+
+	if c.rateLimiter == nil {
+		c.rateLimiter = rate.NewLimiter(rate.Every(time.Minute/subscriptionRatePerMinute), 1)
+	}
+	return c.rateLimiter
+}
+
+// trackSubscription registers a cancelable context derived from parent for
+// one poller goroutine of the given kind and symbol, so Unsubscribe can tear
+// it down independently of the other subscriptions on c. Cancel funcs are
+// appended rather than overwritten, so subscribing to the same (kind,
+// symbol) more than once - or to the same symbol via both SubscribeQuotes
+// and SubscribeBars - tracks every goroutine instead of losing the earlier
+// one's cancel func.
+func (c *Client) trackSubscription(parent context.Context, kind subscriptionKind, symbol string) context.Context {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+
+	if c.subscriptions == nil {
+		c.subscriptions = make(map[subscriptionKey][]context.CancelFunc)
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	key := subscriptionKey{kind: kind, symbol: symbol}
+	c.subscriptions[key] = append(c.subscriptions[key], cancel)
+	return ctx
+}
+
+// Unsubscribe stops polling for the given symbols - both quote and bar
+// subscriptions, and every goroutine if a symbol was subscribed more than
+// once - if they were previously passed to SubscribeQuotes or SubscribeBars
+// on c.
+func (c *Client) Unsubscribe(symbols ...string) {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+
+	for _, symbol := range symbols {
+		for _, kind := range [...]subscriptionKind{quoteSubscription, barSubscription} {
+			key := subscriptionKey{kind: kind, symbol: symbol}
+			for _, cancel := range c.subscriptions[key] {
+				cancel()
+			}
+			delete(c.subscriptions, key)
+		}
+	}
+}
+
+// SubscribeQuotes polls StockQuote for each symbol at interval, emitting a
+// QuoteEvent on the returned channel whenever a new trading day's quote is
+// observed. All subscriptions on c share a token-bucket rate limiter, so
+// subscribing to many symbols on the free tier automatically stays under
+// Alpha Vantage's 5 request/minute limit. Polling for a symbol stops, and
+// its goroutine exits, when ctx is done or Unsubscribe is called for it;
+// the channel closes once every symbol has stopped.
+func (c *Client) SubscribeQuotes(ctx context.Context, symbols []string, interval time.Duration) (<-chan QuoteEvent, error) {
+	events := make(chan QuoteEvent)
+	var wg sync.WaitGroup
+
+	for _, symbol := range symbols {
+		symbolCtx := c.trackSubscription(ctx, quoteSubscription, symbol)
+		wg.Add(1)
+		go func(symbol string, ctx context.Context) {
+			defer wg.Done()
+			c.pollQuote(ctx, symbol, interval, events)
+		}(symbol, symbolCtx)
+	}
+
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	return events, nil
+}
+
+func (c *Client) pollQuote(ctx context.Context, symbol string, interval time.Duration, events chan<- QuoteEvent) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastTradingDay time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.limiter().Wait(ctx); err != nil {
+				return
+			}
+
+			quote, err := c.StockQuote(symbol)
+			if err == nil && quote != nil {
+				if quote.LatestTradingDay.Equal(lastTradingDay) {
+					continue
+				}
+				lastTradingDay = quote.LatestTradingDay
+			}
+
+			select {
+			case events <- QuoteEvent{Symbol: symbol, Value: quote, Err: err, ReceivedAt: time.Now()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// SubscribeBars polls StockTimeSeriesIntraday for each symbol at
+// timeInterval's cadence, emitting a BarEvent on the returned channel
+// whenever a new bar is observed. It shares the same rate limiter and
+// Unsubscribe/ctx semantics as SubscribeQuotes.
+func (c *Client) SubscribeBars(ctx context.Context, symbols []string, timeInterval TimeInterval) (<-chan BarEvent, error) {
+	events := make(chan BarEvent)
+	var wg sync.WaitGroup
+
+	for _, symbol := range symbols {
+		symbolCtx := c.trackSubscription(ctx, barSubscription, symbol)
+		wg.Add(1)
+		go func(symbol string, ctx context.Context) {
+			defer wg.Done()
+			c.pollBars(ctx, symbol, timeInterval, events)
+		}(symbol, symbolCtx)
+	}
+
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	return events, nil
+}
+
+// duration converts a TimeInterval's Alpha Vantage query value (e.g. "5min")
+// into the equivalent time.Duration, used as the natural poll cadence for
+// SubscribeBars. It falls back to one minute if the value can't be parsed.
+func (t TimeInterval) duration() time.Duration {
+	minutes, err := strconv.Atoi(strings.TrimSuffix(t.keyName(), "min"))
+	if err != nil {
+		return time.Minute
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+func (c *Client) pollBars(ctx context.Context, symbol string, timeInterval TimeInterval, events chan<- BarEvent) {
+	ticker := time.NewTicker(timeInterval.duration())
+	defer ticker.Stop()
+
+	var lastTimestamp time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.limiter().Wait(ctx); err != nil {
+				return
+			}
+
+			bars, err := c.StockTimeSeriesIntraday(timeInterval, symbol)
+			if err != nil {
+				select {
+				case events <- BarEvent{Symbol: symbol, Err: err, ReceivedAt: time.Now()}:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+			if len(bars) == 0 {
+				continue
+			}
+
+			latest := bars[len(bars)-1]
+			if latest.Timestamp.Equal(lastTimestamp) {
+				continue
+			}
+			lastTimestamp = latest.Timestamp
+
+			select {
+			case events <- BarEvent{Symbol: symbol, Value: latest, ReceivedAt: time.Now()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package av
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTrackSubscriptionDoesNotOverwriteAcrossKindsOrDuplicates(t *testing.T) {
+	c := &Client{}
+	ctx := context.Background()
+
+	c.trackSubscription(ctx, quoteSubscription, "AAPL")
+	c.trackSubscription(ctx, barSubscription, "AAPL")
+	c.trackSubscription(ctx, quoteSubscription, "AAPL")
+
+	if got := len(c.subscriptions[subscriptionKey{kind: quoteSubscription, symbol: "AAPL"}]); got != 2 {
+		t.Fatalf("expected 2 tracked quote subscriptions for AAPL, got %d", got)
+	}
+	if got := len(c.subscriptions[subscriptionKey{kind: barSubscription, symbol: "AAPL"}]); got != 1 {
+		t.Fatalf("expected 1 tracked bar subscription for AAPL, got %d", got)
+	}
+}
+
+func TestUnsubscribeCancelsEveryTrackedGoroutine(t *testing.T) {
+	c := &Client{}
+	ctx := context.Background()
+
+	quoteCtx1 := c.trackSubscription(ctx, quoteSubscription, "AAPL")
+	quoteCtx2 := c.trackSubscription(ctx, quoteSubscription, "AAPL")
+	barCtx := c.trackSubscription(ctx, barSubscription, "AAPL")
+
+	c.Unsubscribe("AAPL")
+
+	subs := map[string]context.Context{"quote1": quoteCtx1, "quote2": quoteCtx2, "bar": barCtx}
+	for name, subCtx := range subs {
+		select {
+		case <-subCtx.Done():
+		default:
+			t.Fatalf("expected %s subscription to be canceled", name)
+		}
+	}
+}
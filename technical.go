@@ -0,0 +1,137 @@
+package av
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	queryTimePeriod = "time_period"
+	querySeriesType = "series_type"
+
+	technicalAnalysisKeyPrefix = "Technical Analysis"
+)
+
+// IndicatorParams holds the parameters accepted by Alpha Vantage's technical
+// indicator endpoints (SMA, EMA, RSI, MACD, BBANDS, STOCH, ...). Not every
+// indicator uses every field; unused fields are omitted from the request.
+type IndicatorParams struct {
+	// Interval is the time between data points, e.g. "daily" or "60min".
+	Interval string
+	// TimePeriod is the number of data points used to calculate each value,
+	// for indicators that take one (e.g. SMA, EMA, RSI). Zero omits it.
+	TimePeriod int
+	// SeriesType selects the price used for the calculation: "close",
+	// "open", "high", or "low". Empty omits it.
+	SeriesType string
+}
+
+// IndicatorValue is one data point returned by TechnicalIndicator. Values
+// holds the indicator's named outputs (e.g. "SMA", or "MACD", "MACD_Signal",
+// "MACD_Hist" for MACD) keyed by Alpha Vantage's field name.
+type IndicatorValue struct {
+	Time   time.Time
+	Values map[string]float64
+}
+
+// TechnicalIndicator queries any of Alpha Vantage's technical indicator
+// endpoints (e.g. "SMA", "RSI", "MACD", "BBANDS", "STOCH") by function name
+// for symbol, returning the indicator's time series from past to present.
+func (c *Client) TechnicalIndicator(name, symbol string, params IndicatorParams) ([]*IndicatorValue, error) {
+	query := map[string]string{
+		queryEndpoint: name,
+		queryDataType: valueJson,
+		querySymbol:   symbol,
+		queryInterval: params.Interval,
+	}
+	if params.TimePeriod > 0 {
+		query[queryTimePeriod] = strconv.Itoa(params.TimePeriod)
+	}
+	if params.SeriesType != "" {
+		query[querySeriesType] = params.SeriesType
+	}
+	endpoint := c.buildRequestPath(query)
+
+	var indicators []*IndicatorValue
+	err := c.withRetry(func() error {
+		response, err := c.conn.Request(endpoint)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+
+		body, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		indicators, err = parseIndicatorData(body)
+		return err
+	})
+	return indicators, err
+}
+
+// parseIndicatorData unmarshals a technical indicator response, which nests
+// its data under a "Technical Analysis: <NAME>" key alongside a flat
+// "Meta Data" object. The top level is decoded into raw messages first
+// since "Meta Data" isn't shaped like the indicator series and would
+// otherwise fail a single combined Unmarshal.
+func parseIndicatorData(body []byte) ([]*IndicatorValue, error) {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, err
+	}
+
+	var series map[string]map[string]string
+	for key, raw := range envelope {
+		if !strings.HasPrefix(key, technicalAnalysisKeyPrefix) {
+			continue
+		}
+		if err := json.Unmarshal(raw, &series); err != nil {
+			return nil, err
+		}
+		break
+	}
+	if series == nil {
+		return nil, fmt.Errorf("av: no technical analysis data in response")
+	}
+
+	indicators := make([]*IndicatorValue, 0, len(series))
+	for timestamp, fields := range series {
+		parsedTime, err := parseIndicatorTimestamp(timestamp)
+		if err != nil {
+			continue
+		}
+
+		values := make(map[string]float64, len(fields))
+		for field, raw := range fields {
+			if parsed, err := strconv.ParseFloat(raw, 64); err == nil {
+				values[field] = parsed
+			}
+		}
+
+		indicators = append(indicators, &IndicatorValue{Time: parsedTime, Values: values})
+	}
+
+	sort.Slice(indicators, func(i, j int) bool {
+		return indicators[i].Time.Before(indicators[j].Time)
+	})
+
+	return indicators, nil
+}
+
+// parseIndicatorTimestamp parses the date/time keys Alpha Vantage uses for
+// technical indicator data points, which are daily ("2006-01-02") for
+// interval=daily/weekly/monthly, and timestamped ("2006-01-02 15:04:05")
+// for intraday intervals.
+func parseIndicatorTimestamp(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02 15:04:05", value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
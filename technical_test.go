@@ -0,0 +1,61 @@
+package av
+
+import "testing"
+
+func TestParseIndicatorData(t *testing.T) {
+	body := `{
+		"Meta Data": {
+			"1: Symbol": "IBM",
+			"2: Indicator": "Simple Moving Average (SMA)",
+			"3: Last Refreshed": "2024-01-03",
+			"4: Interval": "daily",
+			"5: Time Period": 10,
+			"6: Series Type": "close",
+			"7: Time Zone": "US/Eastern"
+		},
+		"Technical Analysis: SMA": {
+			"2024-01-03": {
+				"SMA": "150.1234"
+			},
+			"2024-01-02": {
+				"SMA": "149.5678"
+			}
+		}
+	}`
+
+	indicators, err := parseIndicatorData([]byte(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(indicators) != 2 {
+		t.Fatalf("expected 2 indicator values, got %d", len(indicators))
+	}
+
+	// parseIndicatorData sorts past to present.
+	if indicators[0].Values["SMA"] != 149.5678 {
+		t.Fatalf("expected the older data point first, got %+v", indicators[0])
+	}
+	if indicators[1].Values["SMA"] != 150.1234 {
+		t.Fatalf("expected the newer data point last, got %+v", indicators[1])
+	}
+}
+
+func TestParseIndicatorDataNoTechnicalAnalysisKey(t *testing.T) {
+	body := `{"Meta Data": {"1: Symbol": "IBM"}}`
+
+	if _, err := parseIndicatorData([]byte(body)); err == nil {
+		t.Fatalf("expected an error when no Technical Analysis key is present")
+	}
+}
+
+func TestParseIndicatorTimestamp(t *testing.T) {
+	if _, err := parseIndicatorTimestamp("2024-01-03"); err != nil {
+		t.Fatalf("unexpected error parsing a date-only timestamp: %v", err)
+	}
+	if _, err := parseIndicatorTimestamp("2024-01-03 09:30:00"); err != nil {
+		t.Fatalf("unexpected error parsing an intraday timestamp: %v", err)
+	}
+	if _, err := parseIndicatorTimestamp("not-a-timestamp"); err == nil {
+		t.Fatalf("expected an error for an unparsable timestamp")
+	}
+}
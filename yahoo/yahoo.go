@@ -0,0 +1,193 @@
+// Package yahoo implements an av.QuoteProvider and av.TimeSeriesProvider
+// backed by Yahoo Finance's quote and chart endpoints, for use as a
+// fallback when Alpha Vantage is rate limited or unavailable.
+package yahoo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	av "github.com/wpwilson10/go-alpha-vantage"
+)
+
+const (
+	host           = "query1.finance.yahoo.com"
+	quotePath      = "/v7/finance/quote"
+	chartPath      = "/v8/finance/chart/"
+	scheme         = "https"
+	querySymbols   = "symbols"
+	queryInterval  = "interval"
+	queryRange     = "range"
+	requestTimeout = time.Second * 30
+)
+
+// Provider queries Yahoo Finance for real time quotes and historical chart
+// data.
+type Provider struct {
+	client *http.Client
+	scheme string
+	host   string
+}
+
+// New creates a Yahoo Finance QuoteProvider/TimeSeriesProvider.
+func New() *Provider {
+	return newProvider(scheme, host)
+}
+
+// newProvider creates a Provider against a specific scheme and host, so
+// tests can point it at an httptest server instead of the real Yahoo
+// Finance API.
+func newProvider(scheme, host string) *Provider {
+	return &Provider{
+		client: &http.Client{Timeout: requestTimeout},
+		scheme: scheme,
+		host:   host,
+	}
+}
+
+type quoteResponseEnvelope struct {
+	QuoteResponse struct {
+		Result []yahooQuote `json:"result"`
+		Error  interface{}  `json:"error"`
+	} `json:"quoteResponse"`
+}
+
+type yahooQuote struct {
+	Symbol                     string  `json:"symbol"`
+	RegularMarketPrice         float64 `json:"regularMarketPrice"`
+	RegularMarketVolume        int64   `json:"regularMarketVolume"`
+	RegularMarketTime          int64   `json:"regularMarketTime"`
+	RegularMarketPreviousClose float64 `json:"regularMarketPreviousClose"`
+}
+
+// Quote implements av.QuoteProvider, normalizing the Yahoo Finance response
+// into the shape returned by av.Client.StockQuote.
+func (p *Provider) Quote(symbol string) (*av.QuoteValue, error) {
+	endpoint := &url.URL{
+		Scheme: p.scheme,
+		Host:   p.host,
+		Path:   quotePath,
+	}
+	query := endpoint.Query()
+	query.Set(querySymbols, strings.ToUpper(symbol))
+	endpoint.RawQuery = query.Encode()
+
+	response, err := p.client.Get(endpoint.String())
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var envelope quoteResponseEnvelope
+	if err := json.NewDecoder(response.Body).Decode(&envelope); err != nil {
+		return nil, err
+	}
+	if len(envelope.QuoteResponse.Result) == 0 {
+		return nil, fmt.Errorf("yahoo: no quote returned for %s", symbol)
+	}
+
+	result := envelope.QuoteResponse.Result[0]
+	return &av.QuoteValue{
+		Symbol:           result.Symbol,
+		Price:            result.RegularMarketPrice,
+		Volume:           result.RegularMarketVolume,
+		PreviousClose:    result.RegularMarketPreviousClose,
+		LatestTradingDay: time.Unix(result.RegularMarketTime, 0),
+	}, nil
+}
+
+type chartResponseEnvelope struct {
+	Chart struct {
+		Result []chartResult `json:"result"`
+		Error  interface{}   `json:"error"`
+	} `json:"chart"`
+}
+
+type chartResult struct {
+	Timestamp  []int64 `json:"timestamp"`
+	Indicators struct {
+		Quote []chartQuote `json:"quote"`
+	} `json:"indicators"`
+}
+
+type chartQuote struct {
+	Open   []float64 `json:"open"`
+	High   []float64 `json:"high"`
+	Low    []float64 `json:"low"`
+	Close  []float64 `json:"close"`
+	Volume []int64   `json:"volume"`
+}
+
+// chartParamsFor maps an av.TimeSeries to the Yahoo Finance chart
+// interval/range query parameters that approximate it; intraday time
+// series are not available through this endpoint.
+func chartParamsFor(timeSeries av.TimeSeries) (interval, dataRange string) {
+	switch timeSeries {
+	case av.Weekly:
+		return "1wk", "2y"
+	case av.Monthly:
+		return "1mo", "10y"
+	default:
+		return "1d", "3mo"
+	}
+}
+
+// TimeSeries implements av.TimeSeriesProvider, normalizing Yahoo Finance's
+// chart response into the shape returned by av.Client.StockTimeSeries.
+func (p *Provider) TimeSeries(timeSeries av.TimeSeries, symbol string) ([]*av.TimeSeriesValue, error) {
+	interval, dataRange := chartParamsFor(timeSeries)
+
+	endpoint := &url.URL{
+		Scheme: p.scheme,
+		Host:   p.host,
+		Path:   chartPath + strings.ToUpper(symbol),
+	}
+	query := endpoint.Query()
+	query.Set(queryInterval, interval)
+	query.Set(queryRange, dataRange)
+	endpoint.RawQuery = query.Encode()
+
+	response, err := p.client.Get(endpoint.String())
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var envelope chartResponseEnvelope
+	if err := json.NewDecoder(response.Body).Decode(&envelope); err != nil {
+		return nil, err
+	}
+	if len(envelope.Chart.Result) == 0 || len(envelope.Chart.Result[0].Indicators.Quote) == 0 {
+		return nil, fmt.Errorf("yahoo: no time series returned for %s", symbol)
+	}
+
+	result := envelope.Chart.Result[0]
+	quote := result.Indicators.Quote[0]
+
+	series := make([]*av.TimeSeriesValue, 0, len(result.Timestamp))
+	for i, timestamp := range result.Timestamp {
+		value := &av.TimeSeriesValue{Timestamp: time.Unix(timestamp, 0)}
+		if i < len(quote.Open) {
+			value.Open = quote.Open[i]
+		}
+		if i < len(quote.High) {
+			value.High = quote.High[i]
+		}
+		if i < len(quote.Low) {
+			value.Low = quote.Low[i]
+		}
+		if i < len(quote.Close) {
+			value.Close = quote.Close[i]
+		}
+		if i < len(quote.Volume) {
+			value.Volume = quote.Volume[i]
+		}
+		series = append(series, value)
+	}
+
+	return series, nil
+}
@@ -0,0 +1,117 @@
+package yahoo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	av "github.com/wpwilson10/go-alpha-vantage"
+)
+
+func newTestProvider(t *testing.T, body string) (*Provider, func()) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error parsing test server URL: %v", err)
+	}
+
+	return newProvider(serverURL.Scheme, serverURL.Host), server.Close
+}
+
+func TestProviderQuote(t *testing.T) {
+	body := `{
+		"quoteResponse": {
+			"result": [{
+				"symbol": "AAPL",
+				"regularMarketPrice": 123.45,
+				"regularMarketVolume": 1000000,
+				"regularMarketTime": 1700000000,
+				"regularMarketPreviousClose": 120.00
+			}],
+			"error": null
+		}
+	}`
+
+	provider, closeServer := newTestProvider(t, body)
+	defer closeServer()
+
+	quote, err := provider.Quote("aapl")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &av.QuoteValue{
+		Symbol:           "AAPL",
+		Price:            123.45,
+		Volume:           1000000,
+		PreviousClose:    120.00,
+		LatestTradingDay: time.Unix(1700000000, 0),
+	}
+	if *quote != *want {
+		t.Fatalf("got %+v, want %+v", quote, want)
+	}
+}
+
+func TestProviderQuoteNoResult(t *testing.T) {
+	provider, closeServer := newTestProvider(t, `{"quoteResponse": {"result": [], "error": null}}`)
+	defer closeServer()
+
+	if _, err := provider.Quote("AAPL"); err == nil {
+		t.Fatalf("expected an error for an empty result, got nil")
+	}
+}
+
+func TestProviderTimeSeries(t *testing.T) {
+	body := `{
+		"chart": {
+			"result": [{
+				"timestamp": [1700000000, 1700086400],
+				"indicators": {
+					"quote": [{
+						"open": [100, 101],
+						"high": [105, 106],
+						"low": [99, 100],
+						"close": [104, 105],
+						"volume": [1000, 2000]
+					}]
+				}
+			}],
+			"error": null
+		}
+	}`
+
+	provider, closeServer := newTestProvider(t, body)
+	defer closeServer()
+
+	series, err := provider.TimeSeries(av.Daily, "AAPL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(series) != 2 {
+		t.Fatalf("expected 2 data points, got %d", len(series))
+	}
+
+	first := series[0]
+	if first.Open != 100 || first.High != 105 || first.Low != 99 || first.Close != 104 || first.Volume != 1000 {
+		t.Fatalf("unexpected first data point: %+v", first)
+	}
+	if !first.Timestamp.Equal(time.Unix(1700000000, 0)) {
+		t.Fatalf("unexpected timestamp: %v", first.Timestamp)
+	}
+}
+
+func TestProviderTimeSeriesNoResult(t *testing.T) {
+	provider, closeServer := newTestProvider(t, `{"chart": {"result": [], "error": null}}`)
+	defer closeServer()
+
+	if _, err := provider.TimeSeries(av.Daily, "AAPL"); err == nil {
+		t.Fatalf("expected an error for an empty result, got nil")
+	}
+}